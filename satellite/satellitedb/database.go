@@ -6,6 +6,7 @@ package satellitedb
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -79,6 +80,27 @@ type Options struct {
 	ReadRollupBatchSize int
 
 	FlightRecorder *flightrecorder.Box
+
+	// Connection pool limits applied to the underlying *sql.DB. Zero keeps
+	// the defaults from the db.max_open_conns, db.max_idle_conns and
+	// db.conn_max_lifetime flags.
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+}
+
+// validate checks that options have sensible values.
+func (opts *Options) validate() error {
+	if opts.MaxOpenConns < 0 {
+		return Error.New("invalid MaxOpenConns %d: must not be negative", opts.MaxOpenConns)
+	}
+	if opts.MaxIdleConns < 0 {
+		return Error.New("invalid MaxIdleConns %d: must not be negative", opts.MaxIdleConns)
+	}
+	if opts.ConnMaxLifetime < 0 {
+		return Error.New("invalid ConnMaxLifetime %v: must not be negative", opts.ConnMaxLifetime)
+	}
+	return nil
 }
 
 var _ dbx.DBMethods = &satelliteDB{}
@@ -96,6 +118,10 @@ var safelyPartitionableDBs = map[string]bool{
 
 // Open creates instance of satellite.DB.
 func Open(ctx context.Context, log *zap.Logger, databaseURL string, opts Options) (rv satellite.DB, err error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
 	dbMapping, err := dbutil.ParseDBMapping(databaseURL)
 	if err != nil {
 		return nil, err
@@ -161,6 +187,15 @@ func open(ctx context.Context, log *zap.Logger, databaseURL string, opts Options
 		name += ":" + override
 	}
 	dbutil.Configure(ctx, dbxDB.DB, name, mon)
+	if opts.MaxOpenConns > 0 {
+		dbxDB.DB.SetMaxOpenConns(opts.MaxOpenConns)
+	}
+	if opts.MaxIdleConns > 0 {
+		dbxDB.DB.SetMaxIdleConns(opts.MaxIdleConns)
+	}
+	if opts.ConnMaxLifetime > 0 {
+		dbxDB.DB.SetConnMaxLifetime(opts.ConnMaxLifetime)
+	}
 
 	core := &satelliteDB{
 		DB: dbxDB,
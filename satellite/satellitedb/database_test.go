@@ -0,0 +1,57 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/satellitedb"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestOpen_ConnectionPoolOptions(t *testing.T) {
+	for _, dbInfo := range satellitedbtest.Databases() {
+		t.Run(dbInfo.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testcontext.New(t)
+			defer ctx.Cleanup()
+
+			if dbInfo.MasterDB.URL == "" {
+				t.Skipf("Database %s connection string not provided. %s", dbInfo.MasterDB.Name, dbInfo.MasterDB.Message)
+			}
+
+			db, err := satellitedbtest.CreateMasterDB(ctx, zaptest.NewLogger(t), t.Name(), "T", 0, dbInfo.MasterDB, satellitedb.Options{
+				ApplicationName: "satellite-satellitedb-test",
+				MaxOpenConns:    7,
+				MaxIdleConns:    3,
+				ConnMaxLifetime: time.Minute,
+			})
+			require.NoError(t, err)
+			defer ctx.Check(db.Close)
+
+			require.Equal(t, 7, db.Testing().RawDB().Stats().MaxOpenConnections)
+		})
+	}
+}
+
+func TestOpen_InvalidConnectionPoolOptions(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := zaptest.NewLogger(t)
+
+	for _, opts := range []satellitedb.Options{
+		{MaxOpenConns: -1},
+		{MaxIdleConns: -1},
+		{ConnMaxLifetime: -time.Second},
+	} {
+		_, err := satellitedb.Open(ctx, log, "postgres://localhost/unused", opts)
+		require.Error(t, err)
+		require.True(t, satellitedb.Error.Has(err))
+	}
+}